@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+// BoltConnection implements GraphConnection on top of the Bolt protocol,
+// targeting Memgraph (or any other Bolt/Cypher-speaking database such as
+// Neo4j).
+type BoltConnection struct {
+	driver     neo4j.DriverWithContext
+	database   string
+	boltLogger log.BoltLogger
+}
+
+// NewBoltConnection wraps an already-constructed Bolt driver as a
+// GraphConnection against the given database. boltLogger, if non-nil,
+// receives every client/server Bolt message for every session this
+// connection opens; see NewSlogBoltLogger for a ready-made implementation.
+func NewBoltConnection(driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger) *BoltConnection {
+	return &BoltConnection{driver: driver, database: database, boltLogger: boltLogger}
+}
+
+func (c *BoltConnection) Close(ctx context.Context) error {
+	return c.driver.Close(ctx)
+}
+
+type developerFixture struct {
+	id   int64
+	name string
+}
+
+type technologyFixture struct {
+	id          int64
+	name        string
+	description string
+}
+
+type lovesFixture struct {
+	developerID  int64
+	technologyID int64
+}
+
+func (c *BoltConnection) InsertData(ctx context.Context) error {
+	indexes := []string{
+		"CREATE INDEX ON :Developer(id);",
+		"CREATE INDEX ON :Technology(id);",
+		"CREATE INDEX ON :Developer(name);",
+		"CREATE INDEX ON :Technology(name);",
+	}
+
+	developers := []developerFixture{
+		{id: 1, name: "Andy"},
+		{id: 2, name: "John"},
+		{id: 3, name: "Michael"},
+	}
+
+	technologies := []technologyFixture{
+		{id: 1, name: "Memgraph", description: "Fastest graph DB in the world!"},
+		{id: 2, name: "Go", description: "Go programming language "},
+		{id: 3, name: "Docker", description: "Docker containerization engine"},
+		{id: 4, name: "Kubernetes", description: "Kubernetes container orchestration engine"},
+		{id: 5, name: "Python", description: "Python programming language"},
+	}
+
+	loves := []lovesFixture{
+		{developerID: 1, technologyID: 1},
+		{developerID: 2, technologyID: 3},
+		{developerID: 3, technologyID: 1},
+		{developerID: 1, technologyID: 5},
+		{developerID: 2, technologyID: 2},
+		{developerID: 3, technologyID: 4},
+	}
+
+	//Create a simple session
+	session := c.driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: c.database, BoltLogger: c.boltLogger})
+	defer session.Close(ctx)
+
+	// Run index queries via implicit auto-commit transaction
+	for _, index := range indexes {
+		_, err := session.Run(ctx, index, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Run every node and relationship insert within a single transaction
+	// via RunPipeline, instead of one neo4j.ExecuteQuery transaction per
+	// statement.
+	queries := make([]PipelinedQuery, 0, len(developers)+len(technologies)+len(loves))
+	for _, d := range developers {
+		queries = append(queries, PipelinedQuery{
+			Cypher: "CREATE (n:Developer {id: $id, name: $name});",
+			Params: map[string]any{"id": d.id, "name": d.name},
+		})
+	}
+	for _, t := range technologies {
+		queries = append(queries, PipelinedQuery{
+			Cypher: "CREATE (n:Technology {id: $id, name: $name, description: $description, createdAt: Date()});",
+			Params: map[string]any{"id": t.id, "name": t.name, "description": t.description},
+		})
+	}
+	for _, l := range loves {
+		queries = append(queries, PipelinedQuery{
+			Cypher: "MATCH (a:Developer {id: $developerID}),(b:Technology {id: $technologyID}) CREATE (a)-[r:LOVES]->(b);",
+			Params: map[string]any{"developerID": l.developerID, "technologyID": l.technologyID},
+		})
+	}
+
+	results, err := RunPipeline(ctx, c.driver, c.database, c.boltLogger, queries)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			return fmt.Errorf("could not insert fixture data: %w", result.Err)
+		}
+	}
+
+	slog.Info("****** All data inserted *******")
+	return nil
+}
+
+func (c *BoltConnection) CountAllNodes(ctx context.Context) (int64, error) {
+	rows, err := Query(ctx, c.driver, c.database, c.boltLogger, "MATCH (n) RETURN count(n) AS count;", nil, decodeColumn[int64]("count"))
+	if err != nil {
+		return 0, err
+	}
+
+	return rows[0], nil
+}
+
+func (c *BoltConnection) CountAllEdges(ctx context.Context) (int64, error) {
+	rows, err := Query(ctx, c.driver, c.database, c.boltLogger, "MATCH ()-[]->() RETURN count(*) AS count;", nil, decodeColumn[int64]("count"))
+	if err != nil {
+		return 0, err
+	}
+
+	return rows[0], nil
+}
+
+func (c *BoltConnection) DeleteEverything(ctx context.Context) error {
+	query := "MATCH (n) DETACH DELETE n;"
+	_, err := neo4j.ExecuteQuery(ctx, c.driver, query, nil,
+		neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase(c.database), neo4j.ExecuteQueryWithBoltLogger(c.boltLogger))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// SlogBoltLogger implements the neo4j-go-driver log.BoltLogger interface by
+// forwarding every client/server Bolt message to a *slog.Logger at DEBUG
+// level, giving protocol-level tracing of a session during test failures.
+type SlogBoltLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogBoltLogger wraps logger as a Bolt protocol logger.
+func NewSlogBoltLogger(logger *slog.Logger) *SlogBoltLogger {
+	return &SlogBoltLogger{logger: logger}
+}
+
+func (l *SlogBoltLogger) LogClientMessage(connContext, msg string, args ...any) {
+	l.log("client", connContext, msg, args...)
+}
+
+func (l *SlogBoltLogger) LogServerMessage(connContext, msg string, args ...any) {
+	l.log("server", connContext, msg, args...)
+}
+
+func (l *SlogBoltLogger) log(direction, connContext, msg string, args ...any) {
+	l.logger.Debug(fmt.Sprintf(msg, args...), "direction", direction, "connection", connContext)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Config bundles the connection parameters for NewDriver, replacing the
+// ad-hoc neo4j.NewDriverWithContext calls previously scattered across main
+// and the test suite.
+type Config struct {
+	URI      string
+	Username string
+	Password string
+	Realm    string
+
+	MaxConnectionPoolSize        int
+	MaxTransactionRetryTime      time.Duration
+	ConnectionAcquisitionTimeout time.Duration
+}
+
+// NewDriver builds a Bolt driver from cfg, applying its pool-size, retry,
+// and acquisition-timeout settings. BoltLogger is a per-session setting in
+// the underlying driver, not a driver-level one, so it is passed to
+// BoltConnection/RunPipeline instead of here.
+func NewDriver(cfg Config) (neo4j.DriverWithContext, error) {
+	return neo4j.NewDriverWithContext(cfg.URI, neo4j.BasicAuth(cfg.Username, cfg.Password, cfg.Realm), func(c *neo4j.Config) {
+		if cfg.MaxConnectionPoolSize > 0 {
+			c.MaxConnectionPoolSize = cfg.MaxConnectionPoolSize
+		}
+		if cfg.MaxTransactionRetryTime > 0 {
+			c.MaxTransactionRetryTime = cfg.MaxTransactionRetryTime
+		}
+		if cfg.ConnectionAcquisitionTimeout > 0 {
+			c.ConnectionAcquisitionTimeout = cfg.ConnectionAcquisitionTimeout
+		}
+	})
+}
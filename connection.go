@@ -0,0 +1,20 @@
+package main
+
+import "context"
+
+// GraphConnection abstracts the handful of graph operations this module
+// needs, so callers can target Memgraph (over Bolt) or Dgraph (over DQL)
+// without rewriting any of the surrounding code.
+type GraphConnection interface {
+	// CountAllNodes returns the total number of nodes in the graph.
+	CountAllNodes(ctx context.Context) (int64, error)
+	// CountAllEdges returns the total number of edges in the graph.
+	CountAllEdges(ctx context.Context) (int64, error)
+	// InsertData seeds the graph with the sample developer/technology
+	// fixture used by main and by the test suite.
+	InsertData(ctx context.Context) error
+	// DeleteEverything removes every node and edge from the graph.
+	DeleteEverything(ctx context.Context) error
+	// Close releases any resources held by the connection.
+	Close(ctx context.Context) error
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/dgraph-io/dgo/v210"
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"google.golang.org/grpc"
+)
+
+// DgraphConnection implements GraphConnection on top of Dgraph, translating
+// the same conceptual operations (count/insert/delete) into DQL mutations
+// and queries instead of Cypher.
+type DgraphConnection struct {
+	client *dgo.Dgraph
+	conn   *grpc.ClientConn
+}
+
+// NewDgraphConnection wraps an already-dialed gRPC connection to a Dgraph
+// Alpha node as a GraphConnection.
+func NewDgraphConnection(conn *grpc.ClientConn) *DgraphConnection {
+	return &DgraphConnection{
+		client: dgo.NewDgraphClient(api.NewDgraphClient(conn)),
+		conn:   conn,
+	}
+}
+
+func (c *DgraphConnection) Close(ctx context.Context) error {
+	return c.conn.Close()
+}
+
+func (c *DgraphConnection) InsertData(ctx context.Context) error {
+	schema := `
+		id: int @index(int) .
+		name: string @index(exact) .
+		description: string .
+		createdAt: datetime .
+		loves: [uid] .
+
+		type Developer {
+			id
+			name
+			loves
+		}
+
+		type Technology {
+			id
+			name
+			description
+			createdAt
+		}
+	`
+	if err := c.client.Alter(ctx, &api.Operation{Schema: schema}); err != nil {
+		return err
+	}
+
+	// The blank nodes below are resolved against each other within this
+	// single mutation, which is the Dgraph equivalent of the Cypher
+	// MATCH-then-CREATE relationship queries in BoltConnection.InsertData.
+	nquads := `
+		_:dev1 <id> "1" .
+		_:dev1 <name> "Andy" .
+		_:dev1 <dgraph.type> "Developer" .
+		_:dev2 <id> "2" .
+		_:dev2 <name> "John" .
+		_:dev2 <dgraph.type> "Developer" .
+		_:dev3 <id> "3" .
+		_:dev3 <name> "Michael" .
+		_:dev3 <dgraph.type> "Developer" .
+
+		_:tech1 <id> "1" .
+		_:tech1 <name> "Memgraph" .
+		_:tech1 <description> "Fastest graph DB in the world!" .
+		_:tech1 <dgraph.type> "Technology" .
+		_:tech2 <id> "2" .
+		_:tech2 <name> "Go" .
+		_:tech2 <description> "Go programming language " .
+		_:tech2 <dgraph.type> "Technology" .
+		_:tech3 <id> "3" .
+		_:tech3 <name> "Docker" .
+		_:tech3 <description> "Docker containerization engine" .
+		_:tech3 <dgraph.type> "Technology" .
+		_:tech4 <id> "4" .
+		_:tech4 <name> "Kubernetes" .
+		_:tech4 <description> "Kubernetes container orchestration engine" .
+		_:tech4 <dgraph.type> "Technology" .
+		_:tech5 <id> "5" .
+		_:tech5 <name> "Python" .
+		_:tech5 <description> "Python programming language" .
+		_:tech5 <dgraph.type> "Technology" .
+
+		_:dev1 <loves> _:tech1 .
+		_:dev2 <loves> _:tech3 .
+		_:dev3 <loves> _:tech1 .
+		_:dev1 <loves> _:tech5 .
+		_:dev2 <loves> _:tech2 .
+		_:dev3 <loves> _:tech4 .
+	`
+
+	txn := c.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	_, err := txn.Mutate(ctx, &api.Mutation{SetNquads: []byte(nquads), CommitNow: true})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("****** All data inserted *******")
+	return nil
+}
+
+func (c *DgraphConnection) CountAllNodes(ctx context.Context) (int64, error) {
+	const query = `{ q(func: has(id)) { count(uid) } }`
+	return c.countFromQuery(ctx, query)
+}
+
+func (c *DgraphConnection) CountAllEdges(ctx context.Context) (int64, error) {
+	// DQL has no direct equivalent of Cypher's `MATCH ()-[]->()`: edges are
+	// counted per predicate. This fixture only ever creates `loves` edges.
+	const query = `{ q(func: has(loves)) { edges: count(loves) } }`
+
+	resp, err := c.client.NewReadOnlyTxn().Query(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	var decoded struct {
+		Q []struct {
+			Edges int64 `json:"edges"`
+		} `json:"q"`
+	}
+	if err := unmarshalDQL(resp.Json, &decoded); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, row := range decoded.Q {
+		total += row.Edges
+	}
+	return total, nil
+}
+
+func (c *DgraphConnection) DeleteEverything(ctx context.Context) error {
+	return c.client.Alter(ctx, &api.Operation{DropAll: true})
+}
+
+func (c *DgraphConnection) countFromQuery(ctx context.Context, query string) (int64, error) {
+	resp, err := c.client.NewReadOnlyTxn().Query(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	var decoded struct {
+		Q []struct {
+			Count int64 `json:"count"`
+		} `json:"q"`
+	}
+	if err := unmarshalDQL(resp.Json, &decoded); err != nil {
+		return 0, err
+	}
+	if len(decoded.Q) == 0 {
+		return 0, nil
+	}
+	return decoded.Q[0].Count, nil
+}
+
+func unmarshalDQL(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("could not decode dgraph response: %w", err)
+	}
+	return nil
+}
@@ -3,18 +3,30 @@ package main
 import (
 	"context"
 	"log"
+	"log/slog"
+	"os"
 	"testing"
 	"time"
 
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
-var driver neo4j.DriverWithContext
+// graphBackendEnvVar selects which GraphConnection implementation the test
+// suite exercises. Defaults to Memgraph when unset.
+const graphBackendEnvVar = "GRAPH_BACKEND"
 
-func startContainer(ctx context.Context) (testcontainers.Container, error) {
+const (
+	backendMemgraph = "memgraph"
+	backendDgraph   = "dgraph"
+)
+
+var conn GraphConnection
+
+func startMemgraphContainer(ctx context.Context) (testcontainers.Container, error) {
 	req := testcontainers.ContainerRequest{
 		Image:        "memgraph/memgraph:latest",
 		ExposedPorts: []string{"7687/tcp"},
@@ -31,44 +43,29 @@ func startContainer(ctx context.Context) (testcontainers.Container, error) {
 	return container, nil
 }
 
-func deleteEverything(ctx context.Context, driver neo4j.DriverWithContext) error {
-	session := driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "memgraph"})
-	defer session.Close(ctx)
-
-	query := "MATCH (n) DETACH DELETE n;"
-	_, err := neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
-	if err != nil {
-		log.Fatalf("Could not delete everything: %s", err)
-	}
-
-	return nil
-}
-
-func TestMain(m *testing.M) {
-	ctx := context.Background()
-
-	container, err := startContainer(ctx)
+func setupMemgraphConnection(ctx context.Context) (GraphConnection, func(), error) {
+	container, err := startMemgraphContainer(ctx)
 	if err != nil {
-		log.Fatalf("Could not setup memgraph container: %s", err)
+		return nil, nil, err
 	}
-	defer func() {
-		if err := container.Terminate(ctx); err != nil {
-			log.Fatalf("Could not terminate memgraph container: %s", err)
-		}
-	}()
 
 	endpoint, err := container.Endpoint(ctx, "bolt")
 	if err != nil {
-		log.Fatalf("Could not get memgraph endpoint: %s", err)
+		return nil, nil, err
 	}
 
-	driver, err = neo4j.NewDriverWithContext(endpoint, neo4j.BasicAuth("memgraph", "memgraph", ""))
+	driver, err := NewDriver(Config{
+		URI:                          endpoint,
+		Username:                     "memgraph",
+		Password:                     "memgraph",
+		MaxConnectionPoolSize:        100,
+		MaxTransactionRetryTime:      30 * time.Second,
+		ConnectionAcquisitionTimeout: 1 * time.Minute,
+	})
 	if err != nil {
-		log.Fatalf("Could not create driver: %s", err)
+		return nil, nil, err
 	}
-	defer driver.Close(ctx)
 
-	// wait for memgraph to be ready
 	attempts := 0
 	for {
 		attempts++
@@ -78,63 +75,146 @@ func TestMain(m *testing.M) {
 		}
 
 		if attempts > 10 {
-			log.Fatalf("Could not connect to memgraph: %s", err)
+			return nil, nil, err
 		}
 
 		time.Sleep(1 * time.Second)
 	}
 
+	teardown := func() {
+		if err := container.Terminate(ctx); err != nil {
+			log.Fatalf("Could not terminate memgraph container: %s", err)
+		}
+	}
+
+	return NewBoltConnection(driver, "memgraph", NewSlogBoltLogger(slog.Default())), teardown, nil
+}
+
+// dgraphNetworkName is the Docker network zero and alpha join so they can
+// resolve each other by hostname.
+const dgraphNetworkName = "dgraph-test-net"
+
+func startDgraphContainers(ctx context.Context) (zero, alpha testcontainers.Container, err error) {
+	_, err = testcontainers.GenericNetwork(ctx, testcontainers.GenericNetworkRequest{
+		NetworkRequest: testcontainers.NetworkRequest{Name: dgraphNetworkName},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zero, err = testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:      "dgraph/dgraph:latest",
+			Cmd:        []string{"dgraph", "zero", "--my=zero:5080"},
+			Networks:   []string{dgraphNetworkName},
+			Hostname:   "zero",
+			WaitingFor: wait.ForLog("Running Dgraph Zero"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alpha, err = testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "dgraph/dgraph:latest",
+			Cmd:          []string{"dgraph", "alpha", "--my=alpha:7080", "--zero=zero:5080"},
+			Networks:     []string{dgraphNetworkName},
+			Hostname:     "alpha",
+			ExposedPorts: []string{"9080/tcp"},
+			WaitingFor:   wait.ForListeningPort("9080/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return zero, alpha, nil
+}
+
+func setupDgraphConnection(ctx context.Context) (GraphConnection, func(), error) {
+	zero, alpha, err := startDgraphContainers(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint, err := alpha.PortEndpoint(ctx, "9080", "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grpcConn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teardown := func() {
+		if err := alpha.Terminate(ctx); err != nil {
+			log.Fatalf("Could not terminate dgraph alpha container: %s", err)
+		}
+		if err := zero.Terminate(ctx); err != nil {
+			log.Fatalf("Could not terminate dgraph zero container: %s", err)
+		}
+	}
+
+	return NewDgraphConnection(grpcConn), teardown, nil
+}
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	backend := os.Getenv(graphBackendEnvVar)
+	if backend == "" {
+		backend = backendMemgraph
+	}
+
+	var teardown func()
+	var err error
+
+	switch backend {
+	case backendMemgraph:
+		conn, teardown, err = setupMemgraphConnection(ctx)
+	case backendDgraph:
+		conn, teardown, err = setupDgraphConnection(ctx)
+	default:
+		log.Fatalf("Unknown %s %q, want %q or %q", graphBackendEnvVar, backend, backendMemgraph, backendDgraph)
+	}
+	if err != nil {
+		log.Fatalf("Could not setup %s connection: %s", backend, err)
+	}
+	defer func() {
+		if err := conn.Close(ctx); err != nil {
+			log.Fatalf("Could not close connection: %s", err)
+		}
+		teardown()
+	}()
+
 	m.Run()
 }
 
 func Test_countAllNodes(t *testing.T) {
 	ctx := context.Background()
-	session := driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "memgraph"})
-	defer session.Close(ctx)
 
 	// before inserting nodes
-	count, err := countAllNodes(ctx, driver)
+	count, err := conn.CountAllNodes(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(0), count)
 
-	// insert nodes
-	nodes := []string{
-		"CREATE (n:A {id: 'a-1', value:'A1'});",
-		"CREATE (n:A {id: 'a-2', value:'A2'});",
-		"CREATE (n:A {id: 'a-3', value:'A3'});",
-		"CREATE (n:B {id: 'b-1', value:'B1'});",
-		"CREATE (n:B {id: 'b-2', value:'B2'});",
-		"CREATE (n:B {id: 'b-3', value:'B3'});",
-		"CREATE (n:C {id: 'c-1', value:'C1'});",
-		"CREATE (n:C {id: 'c-2', value:'C2'});",
-		"CREATE (n:D {id: 'd-1', value:'D1'});",
-	}
-	for _, node := range nodes {
-		_, err := neo4j.ExecuteQuery(ctx, driver, node, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
-		if err != nil {
-			t.Fatalf("Could not insert node: %s", err)
-		}
-	}
-
-	// insterted 9 nodes
-	count, err = countAllNodes(ctx, driver)
-	assert.Nil(t, err)
-	assert.Equal(t, int64(9), count)
-
-	// delete nodes of C label
-	query := "MATCH (n:C) DETACH DELETE n;"
-	_, err = neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
+	// insert data
+	err = conn.InsertData(ctx)
 	if err != nil {
-		t.Fatalf("Could not delete nodes: %s", err)
+		t.Fatalf("Could not insert data: %s", err)
 	}
 
-	// deleted 2 nodes
-	count, err = countAllNodes(ctx, driver)
+	// inserted 3 developers + 5 technologies
+	count, err = conn.CountAllNodes(ctx)
 	assert.Nil(t, err)
-	assert.Equal(t, int64(7), count)
+	assert.Equal(t, int64(8), count)
 
 	// clean up
-	err = deleteEverything(ctx, driver)
+	err = conn.DeleteEverything(ctx)
 	if err != nil {
 		t.Fatalf("Could not delete everything: %s", err)
 	}
@@ -142,56 +222,25 @@ func Test_countAllNodes(t *testing.T) {
 
 func Test_countAllEdges(t *testing.T) {
 	ctx := context.Background()
-	session := driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "memgraph"})
-	defer session.Close(ctx)
 
-	// before inserting nodes
-	count, err := countAllEdges(ctx, driver)
+	// before inserting data
+	count, err := conn.CountAllEdges(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(0), count)
 
-	// insert nodes and relationships
-	nodes := []string{
-		"CREATE (n:Account {id: 'alice'});",
-		"CREATE (n:Account {id: 'bob'});",
-		"CREATE (n:Account {id: 'charlie'});",
-	}
-	for _, node := range nodes {
-		_, err := neo4j.ExecuteQuery(ctx, driver, node, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
-		if err != nil {
-			t.Fatalf("Could not insert node: %s", err)
-		}
-	}
-	edges := []string{
-		"MATCH (a:Account {id: 'alice'}),(b:Account {id: 'bob'}) CREATE (a)-[r:FRIEND]->(b);",
-		"MATCH (a:Account {id: 'alice'}),(c:Account {id: 'charlie'}) CREATE (a)-[r:COLLEAGUE]->(c);",
-		"MATCH (b:Account {id: 'bob'}),(c:Account {id: 'charlie'}) CREATE (b)-[r:FRIEND]->(c);",
-	}
-	for _, edge := range edges {
-		_, err := neo4j.ExecuteQuery(ctx, driver, edge, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
-		if err != nil {
-			t.Fatalf("Could not insert edges: %s", err)
-		}
-	}
-
-	// insterted 3 edges
-	count, err = countAllEdges(ctx, driver)
-	assert.Nil(t, err)
-	assert.Equal(t, int64(3), count)
-
-	query := "MATCH ()-[r:COLLEAGUE]->() DETACH DELETE r;"
-	_, err = neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("memgraph"))
+	// insert data
+	err = conn.InsertData(ctx)
 	if err != nil {
-		t.Fatalf("Could not delete edges: %s", err)
+		t.Fatalf("Could not insert data: %s", err)
 	}
 
-	// deleted 1 edge
-	count, err = countAllEdges(ctx, driver)
+	// inserted 6 LOVES relationships
+	count, err = conn.CountAllEdges(ctx)
 	assert.Nil(t, err)
-	assert.Equal(t, int64(2), count)
+	assert.Equal(t, int64(6), count)
 
 	// clean up
-	err = deleteEverything(ctx, driver)
+	err = conn.DeleteEverything(ctx)
 	if err != nil {
 		t.Fatalf("Could not delete everything: %s", err)
 	}
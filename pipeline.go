@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+// PipelinedQuery is a single Cypher statement plus its bound parameters,
+// queued for batched execution via RunPipeline.
+type PipelinedQuery struct {
+	Cypher string
+	Params map[string]any
+}
+
+// Result is the outcome of a single PipelinedQuery: either its records or
+// the error that query produced.
+type Result struct {
+	Records []*neo4j.Record
+	Err     error
+}
+
+// RunPipeline runs every query in queries within a single explicit
+// transaction on one session, instead of the one-transaction-per-query
+// pattern used by neo4j.ExecuteQuery. This amortizes one BEGIN/COMMIT and
+// one connection acquisition across the whole batch rather than paying for
+// it on every query; it does NOT pipeline RUN/PULL_ALL at the wire level —
+// neo4j-go-driver's tx.Run still blocks on the RUN response before
+// returning, so each statement is still its own Bolt round-trip. A failure
+// in any single query (from tx.Run or from collecting its records) is
+// reported in that query's Result and does not stop the remaining queries
+// from being attempted; only a failure opening the session or the
+// transaction itself short-circuits with a returned error. Since the
+// underlying transaction is atomic, a per-query failure still rolls back
+// every write in the batch — RunPipeline reports what ran, it does not
+// make the writes durable when one of them failed.
+func RunPipeline(ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, queries []PipelinedQuery) ([]Result, error) {
+	session := driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: database, BoltLogger: boltLogger})
+	defer session.Close(ctx)
+
+	tx, err := session.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	results := make([]Result, len(queries))
+	failed := false
+
+	for i, q := range queries {
+		res, err := tx.Run(ctx, q.Cypher, q.Params)
+		if err != nil {
+			results[i] = Result{Err: fmt.Errorf("pipelined query %d (%q): %w", i, q.Cypher, err)}
+			failed = true
+			continue
+		}
+
+		records, err := res.Collect(ctx)
+		if err != nil {
+			results[i] = Result{Err: fmt.Errorf("pipelined query %d (%q): %w", i, q.Cypher, err)}
+			failed = true
+			continue
+		}
+		results[i] = Result{Records: records}
+	}
+
+	if failed {
+		if err := tx.Rollback(ctx); err != nil {
+			return results, fmt.Errorf("pipeline had per-query errors and rollback failed: %w", err)
+		}
+		return results, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
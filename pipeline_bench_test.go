@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// benchFixtureSize is the number of CREATE statements each benchmark
+// iteration runs, several times the 14 node/relationship statements of the
+// developer/technology/loves fixture inserted by InsertData.
+const benchFixtureSize = 50
+
+// BenchmarkInsertData_RunPipeline measures the actual win RunPipeline gets
+// from sharing one explicit transaction across the batch: one BEGIN/COMMIT
+// and one connection acquisition, not wire-level RUN/PULL_ALL pipelining
+// (see the RunPipeline doc comment).
+func BenchmarkInsertData_RunPipeline(b *testing.B) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		b.Skip("RunPipeline is Bolt-specific")
+	}
+
+	ctx := context.Background()
+	queries := make([]PipelinedQuery, benchFixtureSize)
+	for i := range queries {
+		queries[i] = PipelinedQuery{Cypher: "CREATE (n:BenchNode {id: $id});", Params: map[string]any{"id": int64(i)}}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := RunPipeline(ctx, bolt.driver, bolt.database, bolt.boltLogger, queries); err != nil {
+			b.Fatalf("Could not run pipeline: %s", err)
+		}
+		if err := conn.DeleteEverything(ctx); err != nil {
+			b.Fatalf("Could not delete everything: %s", err)
+		}
+	}
+}
+
+// BenchmarkInsertData_ExecuteQueryLoop measures the pre-RunPipeline
+// baseline: one neo4j.ExecuteQuery transaction per statement, as the
+// original insertData loop did.
+func BenchmarkInsertData_ExecuteQueryLoop(b *testing.B) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		b.Skip("neo4j.ExecuteQuery loop is Bolt-specific")
+	}
+
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchFixtureSize; j++ {
+			_, err := neo4j.ExecuteQuery(ctx, bolt.driver, "CREATE (n:BenchNode {id: $id});", map[string]any{"id": int64(j)}, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase(bolt.database))
+			if err != nil {
+				b.Fatalf("Could not execute query: %s", err)
+			}
+		}
+		if err := conn.DeleteEverything(ctx); err != nil {
+			b.Fatalf("Could not delete everything: %s", err)
+		}
+	}
+}
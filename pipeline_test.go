@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RunPipeline(t *testing.T) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		t.Skip("RunPipeline is Bolt-specific")
+	}
+
+	ctx := context.Background()
+
+	queries := []PipelinedQuery{
+		{Cypher: "CREATE (n:PipelineNode {id: $id});", Params: map[string]any{"id": int64(1)}},
+		{Cypher: "CREATE (n:PipelineNode {id: $id});", Params: map[string]any{"id": int64(2)}},
+		{Cypher: "MATCH (n:PipelineNode) RETURN count(n);", Params: nil},
+	}
+
+	results, err := RunPipeline(ctx, bolt.driver, bolt.database, bolt.boltLogger, queries)
+	if err != nil {
+		t.Fatalf("Could not run pipeline: %s", err)
+	}
+
+	assert.Len(t, results, len(queries))
+	assert.Nil(t, results[0].Err)
+	assert.Nil(t, results[1].Err)
+	assert.Nil(t, results[2].Err)
+	assert.Equal(t, int64(2), results[2].Records[0].Values[0].(int64))
+
+	// clean up
+	err = conn.DeleteEverything(ctx)
+	if err != nil {
+		t.Fatalf("Could not delete everything: %s", err)
+	}
+}
+
+func Test_RunPipeline_queryFailure(t *testing.T) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		t.Skip("RunPipeline is Bolt-specific")
+	}
+
+	ctx := context.Background()
+
+	queries := []PipelinedQuery{
+		{Cypher: "CREATE (n:PipelineNode {id: $id});", Params: map[string]any{"id": int64(1)}},
+		{Cypher: "THIS IS NOT VALID CYPHER;", Params: nil},
+		{Cypher: "CREATE (n:PipelineNode {id: $id});", Params: map[string]any{"id": int64(2)}},
+	}
+
+	results, err := RunPipeline(ctx, bolt.driver, bolt.database, bolt.boltLogger, queries)
+	if err != nil {
+		t.Fatalf("Could not run pipeline: %s", err)
+	}
+
+	// the failing query is reported on its own Result instead of
+	// discarding the whole batch
+	assert.Len(t, results, len(queries))
+	assert.NotNil(t, results[1].Err)
+
+	// the batch runs as a single atomic transaction, so a per-query
+	// failure rolls back every write in it, including ones that ran
+	// without error
+	count, err := conn.CountAllNodes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+// Query runs cypher with params against driver and decodes each returned
+// record with decode. A decode failure is wrapped with the Cypher snippet
+// that produced the record, so callers can tell which query a mis-typed
+// column came from. boltLogger, if non-nil, receives every client/server
+// Bolt message neo4j.ExecuteQuery's internal session exchanges.
+func Query[T any](ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, cypher string, params map[string]any, decode func(*neo4j.Record) (T, error)) ([]T, error) {
+	result, err := neo4j.ExecuteQuery(ctx, driver, cypher, params, neo4j.EagerResultTransformer,
+		neo4j.ExecuteQueryWithDatabase(database), neo4j.ExecuteQueryWithBoltLogger(boltLogger))
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]T, 0, len(result.Records))
+	for _, record := range result.Records {
+		row, err := decode(record)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode record for query %q: %w", cypher, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// CountNodes returns the number of nodes with the given label.
+func CountNodes(ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, label string) (int64, error) {
+	cypher := fmt.Sprintf("MATCH (n:%s) RETURN count(n) AS count;", label)
+	rows, err := Query(ctx, driver, database, boltLogger, cypher, nil, decodeColumn[int64]("count"))
+	if err != nil {
+		return 0, err
+	}
+	return rows[0], nil
+}
+
+// CountEdges returns the number of relationships with the given type.
+func CountEdges(ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, relType string) (int64, error) {
+	cypher := fmt.Sprintf("MATCH ()-[r:%s]->() RETURN count(r) AS count;", relType)
+	rows, err := Query(ctx, driver, database, boltLogger, cypher, nil, decodeColumn[int64]("count"))
+	if err != nil {
+		return 0, err
+	}
+	return rows[0], nil
+}
+
+// MatchNodesByLabel returns every node with the given label, decoded with
+// decode.
+func MatchNodesByLabel[T any](ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, label string, decode func(*neo4j.Record) (T, error)) ([]T, error) {
+	cypher := fmt.Sprintf("MATCH (n:%s) RETURN n;", label)
+	return Query(ctx, driver, database, boltLogger, cypher, nil, decode)
+}
+
+// MergeNode creates a node with the given label and props unless a node with
+// that label matching all of props already exists; an existing node is not
+// modified. props are bound as query parameters rather than
+// string-concatenated into the Cypher text.
+func MergeNode(ctx context.Context, driver neo4j.DriverWithContext, database string, boltLogger log.BoltLogger, label string, props map[string]any) error {
+	clauses := make([]string, 0, len(props))
+	for key := range props {
+		clauses = append(clauses, fmt.Sprintf("%s: $%s", key, key))
+	}
+
+	cypher := fmt.Sprintf("MERGE (n:%s {%s});", label, strings.Join(clauses, ", "))
+	_, err := neo4j.ExecuteQuery(ctx, driver, cypher, props, neo4j.EagerResultTransformer,
+		neo4j.ExecuteQueryWithDatabase(database), neo4j.ExecuteQueryWithBoltLogger(boltLogger))
+	return err
+}
+
+// decodeColumn returns a decode func that extracts a single named, typed
+// column from a record, wrapping a missing column or type mismatch with the
+// column name.
+func decodeColumn[T any](column string) func(*neo4j.Record) (T, error) {
+	return func(record *neo4j.Record) (T, error) {
+		var zero T
+		raw, ok := record.Get(column)
+		if !ok {
+			return zero, fmt.Errorf("column %q: not present in record", column)
+		}
+		value, ok := raw.(T)
+		if !ok {
+			return zero, fmt.Errorf("column %q: got %T, want %T", column, raw, zero)
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Query_typeDecoding(t *testing.T) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		t.Skip("Query is Bolt-specific")
+	}
+
+	ctx := context.Background()
+	created := neo4j.DateOf(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	err := MergeNode(ctx, bolt.driver, bolt.database, bolt.boltLogger, "QueryFixture", map[string]any{
+		"id":      int64(1),
+		"name":    "fixture",
+		"created": created,
+		"tags":    []any{"a", "b", "c"},
+	})
+	if err != nil {
+		t.Fatalf("Could not seed query fixture: %s", err)
+	}
+	defer func() {
+		if err := conn.DeleteEverything(ctx); err != nil {
+			t.Fatalf("Could not delete everything: %s", err)
+		}
+	}()
+
+	tests := []struct {
+		name string
+		run  func() (any, error)
+		want any
+	}{
+		{
+			name: "int",
+			run: func() (any, error) {
+				rows, err := Query(ctx, bolt.driver, bolt.database, bolt.boltLogger, "MATCH (n:QueryFixture) RETURN n.id AS value;", nil, decodeColumn[int64]("value"))
+				return firstOrNil(rows), err
+			},
+			want: int64(1),
+		},
+		{
+			name: "string",
+			run: func() (any, error) {
+				rows, err := Query(ctx, bolt.driver, bolt.database, bolt.boltLogger, "MATCH (n:QueryFixture) RETURN n.name AS value;", nil, decodeColumn[string]("value"))
+				return firstOrNil(rows), err
+			},
+			want: "fixture",
+		},
+		{
+			name: "date",
+			run: func() (any, error) {
+				rows, err := Query(ctx, bolt.driver, bolt.database, bolt.boltLogger, "MATCH (n:QueryFixture) RETURN n.created AS value;", nil, decodeColumn[neo4j.Date]("value"))
+				return firstOrNil(rows), err
+			},
+			want: created,
+		},
+		{
+			name: "list",
+			run: func() (any, error) {
+				rows, err := Query(ctx, bolt.driver, bolt.database, bolt.boltLogger, "MATCH (n:QueryFixture) RETURN n.tags AS value;", nil, decodeColumn[[]any]("value"))
+				return firstOrNil(rows), err
+			},
+			want: []any{"a", "b", "c"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.run()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func firstOrNil[T any](rows []T) any {
+	if len(rows) == 0 {
+		return nil
+	}
+	return rows[0]
+}
+
+func Test_CountNodes_MergeNode_MatchNodesByLabel(t *testing.T) {
+	bolt, ok := conn.(*BoltConnection)
+	if !ok {
+		t.Skip("Query is Bolt-specific")
+	}
+
+	ctx := context.Background()
+
+	err := MergeNode(ctx, bolt.driver, bolt.database, bolt.boltLogger, "Widget", map[string]any{"id": int64(1), "name": "sprocket"})
+	if err != nil {
+		t.Fatalf("Could not merge node: %s", err)
+	}
+	defer func() {
+		if err := conn.DeleteEverything(ctx); err != nil {
+			t.Fatalf("Could not delete everything: %s", err)
+		}
+	}()
+
+	count, err := CountNodes(ctx, bolt.driver, bolt.database, bolt.boltLogger, "Widget")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	names, err := MatchNodesByLabel(ctx, bolt.driver, bolt.database, bolt.boltLogger, "Widget", decodeNodeProperty[string]("name"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sprocket"}, names)
+}
+
+func decodeNodeProperty[T any](property string) func(*neo4j.Record) (T, error) {
+	return func(record *neo4j.Record) (T, error) {
+		var zero T
+		raw, ok := record.Get("n")
+		if !ok {
+			return zero, nil
+		}
+		node, ok := raw.(neo4j.Node)
+		if !ok {
+			return zero, nil
+		}
+		value, ok := node.Props[property].(T)
+		if !ok {
+			return zero, nil
+		}
+		return value, nil
+	}
+}